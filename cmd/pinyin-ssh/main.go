@@ -0,0 +1,146 @@
+// Command pinyin-ssh serves the pinyin flashcard TUI over SSH so multiple
+// users can study from the same host, each with their own deck and SRS
+// state keyed by their SSH public key fingerprint.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"chinese_vocab/tui"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/ssh"
+	"github.com/charmbracelet/wish"
+	bm "github.com/charmbracelet/wish/bubbletea"
+	lm "github.com/charmbracelet/wish/logging"
+	"github.com/muesli/termenv"
+	gossh "golang.org/x/crypto/ssh"
+)
+
+const (
+	hostKeyPath  = ".ssh/pinyin_ed25519"
+	dataDir      = "data"
+	shutdownWait = 10 * time.Second
+)
+
+func main() {
+	addr := flag.String("addr", ":2222", "address to listen on")
+	flag.Parse()
+
+	if err := os.MkdirAll(dataDir, 0o755); err != nil {
+		log.Fatalf("could not create data directory: %v", err)
+	}
+
+	sessions := &sessionRegistry{}
+
+	srv, err := wish.NewServer(
+		wish.WithAddress(*addr),
+		wish.WithHostKeyPath(hostKeyPath), // generates a host key on first run
+		// Accept any key but force public-key auth so every client presents
+		// one; without this wish falls back to NoClientAuth and s.PublicKey()
+		// is nil, collapsing everyone onto a single shared "anonymous" deck.
+		wish.WithPublicKeyAuth(func(ctx ssh.Context, key ssh.PublicKey) bool {
+			return true
+		}),
+		wish.WithMiddleware(
+			bm.Middleware(sessions.teaHandler),
+			lm.Middleware(),
+		),
+	)
+	if err != nil {
+		log.Fatalf("could not configure SSH server: %v", err)
+	}
+
+	done := make(chan os.Signal, 1)
+	signal.Notify(done, os.Interrupt, syscall.SIGTERM)
+
+	log.Printf("starting pinyin-ssh on %s", *addr)
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, ssh.ErrServerClosed) {
+			log.Fatalf("SSH server error: %v", err)
+		}
+	}()
+
+	<-done
+	log.Println("shutting down: flushing every session's deck to disk")
+	sessions.saveAll()
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownWait)
+	defer cancel()
+	if err := srv.Shutdown(ctx); err != nil {
+		log.Printf("error during shutdown: %v", err)
+	}
+}
+
+// sessionRegistry tracks every live session's saveable model so the server
+// can flush all decks to disk on shutdown.
+type sessionRegistry struct {
+	mu       sync.Mutex
+	saveable []tui.Saveable
+}
+
+func (r *sessionRegistry) add(s tui.Saveable) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.saveable = append(r.saveable, s)
+}
+
+func (r *sessionRegistry) saveAll() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, s := range r.saveable {
+		s.SaveDeck()
+	}
+}
+
+// teaHandler builds one Bubble Tea program per SSH session, backed by a
+// deck file under data/<pubkey-fingerprint>.json so each user keeps their
+// own cards and SRS schedule.
+func (r *sessionRegistry) teaHandler(s ssh.Session) (tea.Model, []tea.ProgramOption) {
+	_, _, active := s.Pty()
+	if !active {
+		return nil, nil
+	}
+
+	renderer := bm.MakeRenderer(s)
+	renderer.SetColorProfile(termenv.TrueColor)
+
+	deckPath := filepath.Join(dataDir, deckFileName(s)+".json")
+
+	m, err := tui.NewWithDeckPath(deckPath)
+	if err != nil {
+		wish.Fatalln(s, err)
+		return nil, nil
+	}
+
+	r.add(m)
+
+	return m, []tea.ProgramOption{
+		tea.WithAltScreen(),
+		tea.WithOutput(renderer.Output()),
+	}
+}
+
+// deckFileName turns an SSH public key's fingerprint into a filesystem-safe
+// name. The server requires public-key auth (see wish.WithPublicKeyAuth in
+// main), so s.PublicKey() is only nil in pathological cases; such sessions
+// fall back to a shared "anonymous" deck.
+func deckFileName(s ssh.Session) string {
+	pk := s.PublicKey()
+	if pk == nil {
+		return "anonymous"
+	}
+
+	fingerprint := gossh.FingerprintSHA256(pk)
+	return strings.NewReplacer(":", "-", "/", "_", "+", "_").Replace(fingerprint)
+}
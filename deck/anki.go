@@ -0,0 +1,359 @@
+package deck
+
+import (
+	"archive/zip"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	Words "chinese_vocab/words"
+
+	_ "modernc.org/sqlite"
+)
+
+// ankiCollectionFile is the fixed name Anki uses for its SQLite database
+// inside a .apkg (which is itself just a zip archive).
+const ankiCollectionFile = "collection.anki2"
+
+// ankiFieldSep separates a note's fields within the notes.flds column.
+const ankiFieldSep = "\x1f"
+
+// importAnki extracts collection.anki2 from the .apkg zip and reads each
+// note's first three fields as Chinese, Pinyin and Definition.
+func importAnki(path string) ([]Words.Word, error) {
+	r, err := zip.OpenReader(path)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	dbPath, cleanup, err := extractToTemp(&r.Reader, ankiCollectionFile)
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+
+	db, err := sql.Open("sqlite", dbPath)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	rows, err := db.Query("SELECT flds FROM notes")
+	if err != nil {
+		return nil, fmt.Errorf("reading notes: %w", err)
+	}
+	defer rows.Close()
+
+	var words []Words.Word
+	for rows.Next() {
+		var flds string
+		if err := rows.Scan(&flds); err != nil {
+			return nil, err
+		}
+
+		parts := strings.Split(flds, ankiFieldSep)
+		word := Words.Word{}
+		if len(parts) > 0 {
+			word.Chinese = parts[0]
+		}
+		if len(parts) > 1 {
+			word.Pinyin = parts[1]
+		}
+		if len(parts) > 2 {
+			word.Definition = parts[2]
+		}
+		if len(parts) > 3 {
+			word.Example = parts[3]
+		}
+		words = append(words, word)
+	}
+
+	return words, rows.Err()
+}
+
+// extractToTemp copies name out of the zip into a temporary file and returns
+// its path along with a cleanup func that removes it.
+func extractToTemp(r *zip.Reader, name string) (path string, cleanup func(), err error) {
+	f, err := r.Open(name)
+	if err != nil {
+		return "", nil, fmt.Errorf("apkg missing %s: %w", name, err)
+	}
+	defer f.Close()
+
+	tmp, err := os.CreateTemp("", "gopinyin-anki-*.anki2")
+	if err != nil {
+		return "", nil, err
+	}
+	defer tmp.Close()
+
+	if _, err := io.Copy(tmp, f); err != nil {
+		os.Remove(tmp.Name())
+		return "", nil, err
+	}
+
+	return tmp.Name(), func() { os.Remove(tmp.Name()) }, nil
+}
+
+// exportAnki builds a minimal but valid Anki collection: one note type with
+// Chinese/Pinyin/Definition/Example fields, one deck, and one note+card per
+// word, then zips it up as a .apkg.
+func exportAnki(path string, words []Words.Word) error {
+	tmp, err := os.CreateTemp("", "gopinyin-anki-*.anki2")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	tmp.Close()
+
+	if err := writeAnkiCollection(tmp.Name(), words); err != nil {
+		return err
+	}
+
+	return zipFile(path, ankiCollectionFile, tmp.Name())
+}
+
+// ankiModel mirrors the subset of Anki's note-type ("model") schema that
+// real Anki actually reads when rendering a card: without qfmt/afmt Anki
+// has no template to render from, and the other fields below are required
+// by Anki's model validation on import even though gopinyin never uses
+// their values itself.
+type ankiModel struct {
+	ID        int64           `json:"id"`
+	Name      string          `json:"name"`
+	Type      int             `json:"type"`
+	Mod       int64           `json:"mod"`
+	USN       int             `json:"usn"`
+	Sortf     int             `json:"sortf"`
+	Did       int64           `json:"did"`
+	Tmpls     []ankiTmpl      `json:"tmpls"`
+	Flds      []ankiField     `json:"flds"`
+	CSS       string          `json:"css"`
+	LatexPre  string          `json:"latexPre"`
+	LatexPost string          `json:"latexPost"`
+	Req       [][]interface{} `json:"req"`
+	Tags      []string        `json:"tags"`
+	Vers      []int           `json:"vers"`
+}
+
+// ankiTmpl is the single card template gopinyin decks use: front shows
+// Chinese, back reveals Pinyin/Definition/Example.
+type ankiTmpl struct {
+	Name  string `json:"name"`
+	Ord   int    `json:"ord"`
+	Qfmt  string `json:"qfmt"`
+	Afmt  string `json:"afmt"`
+	Bqfmt string `json:"bqfmt"`
+	Bafmt string `json:"bafmt"`
+	Did   *int64 `json:"did"`
+	Bfont string `json:"bfont"`
+	Bsize int    `json:"bsize"`
+}
+
+type ankiField struct {
+	Name   string   `json:"name"`
+	Ord    int      `json:"ord"`
+	Sticky bool     `json:"sticky"`
+	RTL    bool     `json:"rtl"`
+	Font   string   `json:"font"`
+	Size   int      `json:"size"`
+	Media  []string `json:"media"`
+}
+
+// ankiFieldNames is the fixed field order used both for the model's flds
+// definition and for joining/splitting a note's flds column.
+var ankiFieldNames = []string{"Chinese", "Pinyin", "Definition", "Example"}
+
+// newAnkiModel builds the gopinyin note type: one template that shows
+// Chinese on the front and Pinyin/Definition/Example on the back.
+func newAnkiModel(modelID, deckID int64, now int64) ankiModel {
+	flds := make([]ankiField, len(ankiFieldNames))
+	for i, name := range ankiFieldNames {
+		flds[i] = ankiField{Name: name, Ord: i, Font: "Arial", Size: 20, Media: []string{}}
+	}
+
+	return ankiModel{
+		ID:    modelID,
+		Name:  "gopinyin",
+		Type:  0,
+		Mod:   now,
+		Sortf: 0,
+		Did:   deckID,
+		Tmpls: []ankiTmpl{{
+			Name:  "Card 1",
+			Ord:   0,
+			Qfmt:  "{{Chinese}}",
+			Afmt:  "{{FrontSide}}\n\n<hr id=answer>\n\n{{Pinyin}}<br>{{Definition}}<br>{{Example}}",
+			Did:   nil,
+			Bsize: 0,
+		}},
+		Flds:      flds,
+		CSS:       ".card {\n font-family: arial;\n font-size: 20px;\n text-align: center;\n color: black;\n background-color: white;\n}",
+		LatexPre:  "\\documentclass[12pt]{article}\n\\special{papersize=3in,5in}\n\\usepackage[utf8]{inputenc}\n\\usepackage{amssymb,amsmath}\n\\pagestyle{empty}\n\\setlength{\\parindent}{0in}\n\\begin{document}\n",
+		LatexPost: "\\end{document}",
+		Req:       [][]interface{}{{0, "any", []int{0}}},
+		Tags:      []string{},
+		Vers:      []int{},
+	}
+}
+
+// writeAnkiCollection creates the collection.anki2 schema Anki expects and
+// inserts one note per word, carrying over each word's SM-2 state as the
+// card's scheduling fields so review progress survives a round trip.
+func writeAnkiCollection(dbPath string, words []Words.Word) error {
+	db, err := sql.Open("sqlite", dbPath)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	schema := `
+CREATE TABLE col (
+	id integer PRIMARY KEY,
+	crt integer NOT NULL,
+	mod integer NOT NULL,
+	scm integer NOT NULL,
+	ver integer NOT NULL,
+	dty integer NOT NULL,
+	usn integer NOT NULL,
+	ls integer NOT NULL,
+	conf text NOT NULL,
+	models text NOT NULL,
+	decks text NOT NULL,
+	dconf text NOT NULL,
+	tags text NOT NULL
+);
+CREATE TABLE notes (
+	id integer PRIMARY KEY,
+	guid text NOT NULL,
+	mid integer NOT NULL,
+	mod integer NOT NULL,
+	usn integer NOT NULL,
+	tags text NOT NULL,
+	flds text NOT NULL,
+	sfld text NOT NULL,
+	csum integer NOT NULL,
+	flags integer NOT NULL,
+	data text NOT NULL
+);
+CREATE TABLE cards (
+	id integer PRIMARY KEY,
+	nid integer NOT NULL,
+	did integer NOT NULL,
+	ord integer NOT NULL,
+	mod integer NOT NULL,
+	usn integer NOT NULL,
+	type integer NOT NULL,
+	queue integer NOT NULL,
+	due integer NOT NULL,
+	ivl integer NOT NULL,
+	factor integer NOT NULL,
+	reps integer NOT NULL,
+	lapses integer NOT NULL,
+	left integer NOT NULL,
+	odue integer NOT NULL,
+	odid integer NOT NULL,
+	flags integer NOT NULL,
+	data text NOT NULL
+);
+CREATE TABLE revlog (
+	id integer PRIMARY KEY,
+	cid integer NOT NULL,
+	usn integer NOT NULL,
+	ease integer NOT NULL,
+	ivl integer NOT NULL,
+	lastIvl integer NOT NULL,
+	factor integer NOT NULL,
+	time integer NOT NULL,
+	type integer NOT NULL
+);
+`
+	if _, err := db.Exec(schema); err != nil {
+		return err
+	}
+
+	now := time.Now().Unix()
+	const modelID = 1
+	const deckID = 1
+
+	modelsData, err := json.Marshal(map[string]ankiModel{
+		fmt.Sprint(modelID): newAnkiModel(modelID, deckID, now),
+	})
+	if err != nil {
+		return err
+	}
+	models := string(modelsData)
+	decks := fmt.Sprintf(`{"%d":{"id":%d,"name":"gopinyin"}}`, deckID, deckID)
+
+	_, err = db.Exec(
+		`INSERT INTO col (id, crt, mod, scm, ver, dty, usn, ls, conf, models, decks, dconf, tags)
+		 VALUES (1, ?, ?, ?, 11, 0, 0, 0, '{}', ?, ?, '{}', '{}')`,
+		now, now, now, models, decks,
+	)
+	if err != nil {
+		return err
+	}
+
+	for i, word := range words {
+		id := int64(i + 1)
+		flds := strings.Join([]string{word.Chinese, word.Pinyin, word.Definition, word.Example}, ankiFieldSep)
+
+		_, err := db.Exec(
+			`INSERT INTO notes (id, guid, mid, mod, usn, tags, flds, sfld, csum, flags, data)
+			 VALUES (?, ?, ?, ?, 0, '', ?, ?, 0, 0, '')`,
+			id, fmt.Sprintf("gopinyin-%d", id), modelID, now, flds, word.Chinese,
+		)
+		if err != nil {
+			return err
+		}
+
+		factor := int(word.EaseFactor * 1000)
+		if factor == 0 {
+			factor = 2500
+		}
+
+		_, err = db.Exec(
+			`INSERT INTO cards (id, nid, did, ord, mod, usn, type, queue, due, ivl, factor, reps, lapses, left, odue, odid, flags, data)
+			 VALUES (?, ?, ?, 0, ?, 0, 0, 0, ?, ?, ?, ?, 0, 0, 0, 0, 0, '')`,
+			id, id, deckID, now, word.Interval, word.Interval, factor, word.Repetitions,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// zipFile writes a single file (read from srcPath) into a new zip archive at
+// destPath under the given archive name.
+func zipFile(destPath, archiveName, srcPath string) error {
+	out, err := os.Create(destPath)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	zw := zip.NewWriter(out)
+
+	w, err := zw.Create(archiveName)
+	if err != nil {
+		return err
+	}
+
+	src, err := os.Open(srcPath)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	if _, err := io.Copy(w, src); err != nil {
+		return err
+	}
+
+	return zw.Close()
+}
@@ -0,0 +1,75 @@
+package deck
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+
+	Words "chinese_vocab/words"
+)
+
+var csvHeader = []string{"Chinese", "Pinyin", "Definition", "Example"}
+
+func importCSV(path string) ([]Words.Word, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r := csv.NewReader(f)
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	// Skip the header row if present.
+	rows := records
+	if records[0][0] == csvHeader[0] {
+		rows = records[1:]
+	}
+
+	words := make([]Words.Word, 0, len(rows))
+	for i, row := range rows {
+		if len(row) < 3 {
+			return nil, fmt.Errorf("row %d: expected at least 3 columns, got %d", i, len(row))
+		}
+
+		word := Words.Word{
+			Chinese:    row[0],
+			Pinyin:     row[1],
+			Definition: row[2],
+		}
+		if len(row) > 3 {
+			word.Example = row[3]
+		}
+		words = append(words, word)
+	}
+
+	return words, nil
+}
+
+func exportCSV(path string, words []Words.Word) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	w := csv.NewWriter(f)
+	if err := w.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, word := range words {
+		row := []string{word.Chinese, word.Pinyin, word.Definition, word.Example}
+		if err := w.Write(row); err != nil {
+			return err
+		}
+	}
+
+	w.Flush()
+	return w.Error()
+}
@@ -0,0 +1,42 @@
+// Package deck imports and exports vocabulary decks in the formats users
+// are likely to already have cards in: a plain JSON dump of Words.Word,
+// CSV, and Anki's .apkg package.
+package deck
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	Words "chinese_vocab/words"
+)
+
+// Import reads the deck at path, detecting its format from the file
+// extension (.csv, .json, or .apkg).
+func Import(path string) ([]Words.Word, error) {
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".csv":
+		return importCSV(path)
+	case ".json":
+		return importJSON(path)
+	case ".apkg":
+		return importAnki(path)
+	default:
+		return nil, fmt.Errorf("unsupported import format %q", ext)
+	}
+}
+
+// Export writes words to path, detecting its format from the file
+// extension (.csv, .json, or .apkg).
+func Export(path string, words []Words.Word) error {
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".csv":
+		return exportCSV(path, words)
+	case ".json":
+		return exportJSON(path, words)
+	case ".apkg":
+		return exportAnki(path, words)
+	default:
+		return fmt.Errorf("unsupported export format %q", ext)
+	}
+}
@@ -0,0 +1,56 @@
+package deck
+
+import (
+	"path/filepath"
+	"testing"
+
+	Words "chinese_vocab/words"
+)
+
+func testWords() []Words.Word {
+	return []Words.Word{
+		{Chinese: "你好", Pinyin: "nǐ hǎo", Definition: "Hello", Example: "你好吗？"},
+		{Chinese: "谢谢", Pinyin: "xiè xiè", Definition: "Thank you", Example: ""},
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, ext := range []string{".csv", ".json", ".apkg"} {
+		t.Run(ext, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "deck"+ext)
+			want := testWords()
+
+			if err := Export(path, want); err != nil {
+				t.Fatalf("Export() error = %v", err)
+			}
+
+			got, err := Import(path)
+			if err != nil {
+				t.Fatalf("Import() error = %v", err)
+			}
+
+			if len(got) != len(want) {
+				t.Fatalf("Import() returned %d words, want %d", len(got), len(want))
+			}
+			for i := range want {
+				if got[i].Chinese != want[i].Chinese ||
+					got[i].Pinyin != want[i].Pinyin ||
+					got[i].Definition != want[i].Definition ||
+					got[i].Example != want[i].Example {
+					t.Errorf("word %d = %+v, want %+v", i, got[i], want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestImportExportUnsupportedFormat(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "deck.txt")
+
+	if err := Export(path, testWords()); err == nil {
+		t.Error("Export() error = nil, want an error for an unsupported extension")
+	}
+	if _, err := Import(path); err == nil {
+		t.Error("Import() error = nil, want an error for an unsupported extension")
+	}
+}
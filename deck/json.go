@@ -0,0 +1,29 @@
+package deck
+
+import (
+	"encoding/json"
+	"os"
+
+	Words "chinese_vocab/words"
+)
+
+func importJSON(path string) ([]Words.Word, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var words []Words.Word
+	if err := json.Unmarshal(data, &words); err != nil {
+		return nil, err
+	}
+	return words, nil
+}
+
+func exportJSON(path string, words []Words.Word) error {
+	data, err := json.MarshalIndent(words, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
@@ -0,0 +1,92 @@
+package providers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	Words "chinese_vocab/words"
+)
+
+const (
+	defaultAnthropicModel   = "claude-3-5-sonnet-latest"
+	anthropicAPIVersion     = "2023-06-01"
+	anthropicMessagesMaxTok = 256
+)
+
+// AnthropicProvider generates examples via the Anthropic Messages API.
+type AnthropicProvider struct {
+	apiKey string
+	model  string
+	client *http.Client
+}
+
+// NewAnthropicProvider builds an AnthropicProvider. An empty model falls
+// back to claude-3-5-sonnet-latest.
+func NewAnthropicProvider(apiKey, model string) *AnthropicProvider {
+	if model == "" {
+		model = defaultAnthropicModel
+	}
+	return &AnthropicProvider{apiKey: apiKey, model: model, client: &http.Client{}}
+}
+
+func (p *AnthropicProvider) FetchExample(ctx context.Context, word Words.Word) (string, error) {
+	prompt := fmt.Sprintf("Give me an example phrase in Chinese, Pinyin, and English with the following word: %s", word.Chinese)
+
+	requestBody := map[string]interface{}{
+		"model":      p.model,
+		"max_tokens": anthropicMessagesMaxTok,
+		"messages": []map[string]string{
+			{"role": "user", "content": prompt},
+		},
+	}
+
+	requestData, err := json.Marshal(requestBody)
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", "https://api.anthropic.com/v1/messages", bytes.NewBuffer(requestData))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("x-api-key", p.apiKey)
+	req.Header.Set("anthropic-version", anthropicAPIVersion)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	var responseData struct {
+		Content []struct {
+			Text string `json:"text"`
+		} `json:"content"`
+		Error struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(body, &responseData); err != nil {
+		return "", err
+	}
+
+	if responseData.Error.Message != "" {
+		return "", errors.New(responseData.Error.Message)
+	}
+	if len(responseData.Content) == 0 {
+		return "", fmt.Errorf("no content returned")
+	}
+
+	return responseData.Content[0].Text, nil
+}
@@ -0,0 +1,92 @@
+package providers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	Words "chinese_vocab/words"
+)
+
+// CompatibleProvider targets any OpenAI-compatible chat completions endpoint
+// (LocalAI, Ollama's OpenAI shim, vLLM, etc.) via a configurable base URL.
+type CompatibleProvider struct {
+	baseURL string
+	apiKey  string
+	model   string
+	client  *http.Client
+}
+
+// NewCompatibleProvider builds a CompatibleProvider targeting baseURL.
+func NewCompatibleProvider(baseURL, apiKey, model string) *CompatibleProvider {
+	return &CompatibleProvider{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		apiKey:  apiKey,
+		model:   model,
+		client:  &http.Client{},
+	}
+}
+
+func (p *CompatibleProvider) FetchExample(ctx context.Context, word Words.Word) (string, error) {
+	prompt := fmt.Sprintf("Give me an example phrase in Chinese, Pinyin, and English with the following word: %s", word.Chinese)
+
+	requestBody := map[string]interface{}{
+		"model": p.model,
+		"messages": []map[string]string{
+			{"role": "user", "content": prompt},
+		},
+	}
+
+	requestData, err := json.Marshal(requestBody)
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", p.baseURL+"/chat/completions", bytes.NewBuffer(requestData))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if p.apiKey != "" {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", p.apiKey))
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	var responseData struct {
+		Choices []struct {
+			Message struct {
+				Content string `json:"content"`
+			} `json:"message"`
+		} `json:"choices"`
+		Error struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(body, &responseData); err != nil {
+		return "", err
+	}
+
+	if responseData.Error.Message != "" {
+		return "", errors.New(responseData.Error.Message)
+	}
+	if len(responseData.Choices) == 0 {
+		return "", fmt.Errorf("no choices returned")
+	}
+
+	return responseData.Choices[0].Message.Content, nil
+}
@@ -0,0 +1,55 @@
+package providers
+
+import (
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// fileConfig mirrors the subset of config.yaml this package understands.
+// Any field left unset falls back to the matching environment variable.
+type fileConfig struct {
+	Provider string `yaml:"provider"`
+	Model    string `yaml:"model"`
+	BaseURL  string `yaml:"baseUrl"`
+	APIKey   string `yaml:"apiKey"`
+}
+
+// LoadConfig builds a Config from config.yaml (if present at path) layered
+// with environment variables, which always take precedence. OPENAI_API_KEY
+// is honored as a fallback for API_KEY so existing .env files keep working.
+func LoadConfig(path string) (Config, error) {
+	var fc fileConfig
+
+	if data, err := os.ReadFile(path); err == nil {
+		if err := yaml.Unmarshal(data, &fc); err != nil {
+			return Config{}, err
+		}
+	} else if !os.IsNotExist(err) {
+		return Config{}, err
+	}
+
+	cfg := Config{
+		Provider: fc.Provider,
+		Model:    fc.Model,
+		BaseURL:  fc.BaseURL,
+		APIKey:   fc.APIKey,
+	}
+
+	if v := os.Getenv("GOPINYIN_PROVIDER"); v != "" {
+		cfg.Provider = v
+	}
+	if v := os.Getenv("GOPINYIN_MODEL"); v != "" {
+		cfg.Model = v
+	}
+	if v := os.Getenv("GOPINYIN_BASE_URL"); v != "" {
+		cfg.BaseURL = v
+	}
+	if v := os.Getenv("GOPINYIN_API_KEY"); v != "" {
+		cfg.APIKey = v
+	} else if v := os.Getenv("OPENAI_API_KEY"); v != "" && cfg.APIKey == "" {
+		cfg.APIKey = v
+	}
+
+	return cfg, nil
+}
@@ -0,0 +1,157 @@
+package providers
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	Words "chinese_vocab/words"
+)
+
+const defaultOpenAIModel = "gpt-3.5-turbo"
+
+// OpenAIProvider generates examples via the OpenAI chat completions API.
+type OpenAIProvider struct {
+	apiKey string
+	model  string
+	client *http.Client
+}
+
+// NewOpenAIProvider builds an OpenAIProvider. An empty model falls back to
+// gpt-3.5-turbo.
+func NewOpenAIProvider(apiKey, model string) *OpenAIProvider {
+	if model == "" {
+		model = defaultOpenAIModel
+	}
+	return &OpenAIProvider{apiKey: apiKey, model: model, client: &http.Client{}}
+}
+
+func (p *OpenAIProvider) FetchExample(ctx context.Context, word Words.Word) (string, error) {
+	prompt := fmt.Sprintf("Give me an example phrase in Chinese, Pinyin, and English with the following word: %s", word.Chinese)
+
+	requestBody := map[string]interface{}{
+		"model": p.model,
+		"messages": []map[string]string{
+			{"role": "user", "content": prompt},
+		},
+	}
+
+	requestData, err := json.Marshal(requestBody)
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", "https://api.openai.com/v1/chat/completions", bytes.NewBuffer(requestData))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", p.apiKey))
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	var responseData struct {
+		Choices []struct {
+			Message struct {
+				Content string `json:"content"`
+			} `json:"message"`
+		} `json:"choices"`
+		Error struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(body, &responseData); err != nil {
+		return "", err
+	}
+
+	if responseData.Error.Message != "" {
+		return "", errors.New(responseData.Error.Message)
+	}
+	if len(responseData.Choices) == 0 {
+		return "", fmt.Errorf("no choices returned")
+	}
+
+	return responseData.Choices[0].Message.Content, nil
+}
+
+// StreamExample streams the example sentence token-by-token via OpenAI's
+// server-sent-events chat completions mode, invoking onDelta for each token
+// as it arrives. It returns once the stream emits "[DONE]" or ctx is done.
+func (p *OpenAIProvider) StreamExample(ctx context.Context, word Words.Word, onDelta func(string)) error {
+	prompt := fmt.Sprintf("Give me an example phrase in Chinese, Pinyin, and English with the following word: %s", word.Chinese)
+
+	requestBody := map[string]interface{}{
+		"model":  p.model,
+		"stream": true,
+		"messages": []map[string]string{
+			{"role": "user", "content": prompt},
+		},
+	}
+
+	requestData, err := json.Marshal(requestBody)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", "https://api.openai.com/v1/chat/completions", bytes.NewBuffer(requestData))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", p.apiKey))
+	req.Header.Set("Accept", "text/event-stream")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || !strings.HasPrefix(line, "data: ") {
+			continue
+		}
+
+		data := strings.TrimPrefix(line, "data: ")
+		if data == "[DONE]" {
+			return nil
+		}
+
+		var chunk struct {
+			Choices []struct {
+				Delta struct {
+					Content string `json:"content"`
+				} `json:"delta"`
+			} `json:"choices"`
+		}
+		if err := json.Unmarshal([]byte(data), &chunk); err != nil {
+			return err
+		}
+		if len(chunk.Choices) == 0 {
+			continue
+		}
+
+		if delta := chunk.Choices[0].Delta.Content; delta != "" {
+			onDelta(delta)
+		}
+	}
+
+	return scanner.Err()
+}
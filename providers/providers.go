@@ -0,0 +1,50 @@
+// Package providers abstracts away the LLM backend used to generate example
+// sentences for a vocabulary card, so the TUI can target OpenAI, Anthropic,
+// an OpenAI-compatible endpoint (LocalAI/Ollama/vLLM), or canned output for
+// tests without changing any caller code.
+package providers
+
+import (
+	"context"
+	"fmt"
+
+	Words "chinese_vocab/words"
+)
+
+// ExampleProvider generates an example sentence for a vocabulary word.
+type ExampleProvider interface {
+	FetchExample(ctx context.Context, word Words.Word) (string, error)
+}
+
+// StreamingExampleProvider is an optional capability of an ExampleProvider:
+// providers that can stream tokens back incrementally implement it too, and
+// callers type-assert for it to opt into incremental rendering.
+type StreamingExampleProvider interface {
+	ExampleProvider
+	StreamExample(ctx context.Context, word Words.Word, onDelta func(string)) error
+}
+
+// Config selects and configures an ExampleProvider.
+type Config struct {
+	// Provider is one of "openai", "anthropic", "compatible", or "static".
+	Provider string
+	Model    string
+	BaseURL  string
+	APIKey   string
+}
+
+// New builds the ExampleProvider selected by cfg.Provider.
+func New(cfg Config) (ExampleProvider, error) {
+	switch cfg.Provider {
+	case "", "openai":
+		return NewOpenAIProvider(cfg.APIKey, cfg.Model), nil
+	case "anthropic":
+		return NewAnthropicProvider(cfg.APIKey, cfg.Model), nil
+	case "compatible", "openai-compatible":
+		return NewCompatibleProvider(cfg.BaseURL, cfg.APIKey, cfg.Model), nil
+	case "static":
+		return NewStaticProvider(), nil
+	default:
+		return nil, fmt.Errorf("unknown provider %q", cfg.Provider)
+	}
+}
@@ -0,0 +1,31 @@
+package providers
+
+import (
+	"context"
+	"fmt"
+
+	Words "chinese_vocab/words"
+)
+
+// canned holds hand-written examples for the words that ship in
+// Words.ExampleWords, keyed by the Chinese characters.
+var canned = map[string]string{
+	"你好": "你好，很高兴认识你。(Nǐ hǎo, hěn gāoxìng rènshi nǐ.) - Hello, nice to meet you.",
+	"谢谢": "谢谢你的帮助。(Xièxiè nǐ de bāngzhù.) - Thank you for your help.",
+}
+
+// StaticProvider returns canned example sentences without making any
+// network calls, so it can be used offline and in tests.
+type StaticProvider struct{}
+
+// NewStaticProvider builds a StaticProvider.
+func NewStaticProvider() *StaticProvider {
+	return &StaticProvider{}
+}
+
+func (p *StaticProvider) FetchExample(ctx context.Context, word Words.Word) (string, error) {
+	if example, ok := canned[word.Chinese]; ok {
+		return example, nil
+	}
+	return fmt.Sprintf("%s (%s) means %s.", word.Chinese, word.Pinyin, word.Definition), nil
+}
@@ -0,0 +1,70 @@
+package providers
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	Words "chinese_vocab/words"
+)
+
+func TestStaticProviderFetchExample(t *testing.T) {
+	p := NewStaticProvider()
+
+	t.Run("returns the canned example for a known word", func(t *testing.T) {
+		got, err := p.FetchExample(context.Background(), Words.Word{Chinese: "你好"})
+		if err != nil {
+			t.Fatalf("FetchExample() error = %v", err)
+		}
+		if got != canned["你好"] {
+			t.Errorf("FetchExample() = %q, want %q", got, canned["你好"])
+		}
+	})
+
+	t.Run("falls back to a generated example for an unknown word", func(t *testing.T) {
+		word := Words.Word{Chinese: "猫", Pinyin: "māo", Definition: "Cat"}
+		got, err := p.FetchExample(context.Background(), word)
+		if err != nil {
+			t.Fatalf("FetchExample() error = %v", err)
+		}
+		want := "猫 (māo) means Cat."
+		if got != want {
+			t.Errorf("FetchExample() = %q, want %q", got, want)
+		}
+	})
+}
+
+func TestNewProvider(t *testing.T) {
+	tests := []struct {
+		provider string
+		wantType string
+		wantErr  bool
+	}{
+		{provider: "", wantType: "*providers.OpenAIProvider"},
+		{provider: "openai", wantType: "*providers.OpenAIProvider"},
+		{provider: "anthropic", wantType: "*providers.AnthropicProvider"},
+		{provider: "compatible", wantType: "*providers.CompatibleProvider"},
+		{provider: "openai-compatible", wantType: "*providers.CompatibleProvider"},
+		{provider: "static", wantType: "*providers.StaticProvider"},
+		{provider: "bogus", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.provider, func(t *testing.T) {
+			got, err := New(Config{Provider: tt.provider})
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("New() error = nil, want an error for an unknown provider")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("New() error = %v", err)
+			}
+
+			if gotType := fmt.Sprintf("%T", got); gotType != tt.wantType {
+				t.Errorf("New() returned %s, want %s", gotType, tt.wantType)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package tui
+
+import (
+	"fmt"
+
+	Words "chinese_vocab/words"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// addModel is the "add a new card" form: four text inputs for Chinese,
+// Pinyin, Definition, and an optional example.
+type addModel struct {
+	base *basemodel
+
+	inputs     []textinput.Model
+	focusIndex int
+}
+
+func newAddModel(base *basemodel) addModel {
+	inputs := make([]textinput.Model, 4)
+	for i := range inputs {
+		t := textinput.New()
+		t.Prompt = "» "
+		t.CharLimit = 50
+
+		switch i {
+		case 0:
+			t.Placeholder = "Chinese Characters (e.g. 你好)"
+		case 1:
+			t.Placeholder = "Pinyin (e.g. ni hao)"
+		case 2:
+			t.Placeholder = "Definition (e.g. Hello)"
+		case 3:
+			t.Placeholder = "Example Sentence (optional)"
+		}
+
+		inputs[i] = t
+	}
+	inputs[0].Focus()
+
+	return addModel{base: base, inputs: inputs}
+}
+
+func (m addModel) Init() tea.Cmd {
+	return textinput.Blink
+}
+
+func (m addModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+
+	switch keyMsg.Type {
+	case tea.KeyCtrlC, tea.KeyEsc:
+		return m, switchScreen(screenBrowse)
+
+	case tea.KeyTab, tea.KeyShiftTab:
+		if keyMsg.Type == tea.KeyTab {
+			m.focusIndex++
+		} else {
+			m.focusIndex--
+		}
+
+		if m.focusIndex > len(m.inputs)-1 {
+			m.focusIndex = 0
+		} else if m.focusIndex < 0 {
+			m.focusIndex = len(m.inputs) - 1
+		}
+
+		for i := range m.inputs {
+			if i == m.focusIndex {
+				m.inputs[i].Focus()
+			} else {
+				m.inputs[i].Blur()
+			}
+		}
+		return m, nil
+
+	case tea.KeyEnter:
+		if m.inputs[0].Value() != "" && m.inputs[1].Value() != "" && m.inputs[2].Value() != "" {
+			newWord := Words.Word{
+				Chinese:    m.inputs[0].Value(),
+				Pinyin:     m.inputs[1].Value(),
+				Definition: m.inputs[2].Value(),
+				Example:    m.inputs[3].Value(),
+			}
+			m.base.mu.Lock()
+			m.base.words = append(m.base.words, newWord)
+			m.base.mu.Unlock()
+			return newAddModel(m.base), switchScreen(screenBrowse)
+		}
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	var cmds []tea.Cmd
+	for i := range m.inputs {
+		m.inputs[i], cmd = m.inputs[i].Update(keyMsg)
+		cmds = append(cmds, cmd)
+	}
+	return m, tea.Batch(cmds...)
+}
+
+func (m addModel) View() string {
+	s := titleStyle.Render("Add New Vocabulary Card") + "\n\n"
+
+	labels := []string{
+		"Chinese Characters:",
+		"Pinyin:",
+		"Definition:",
+		"Example (optional):",
+	}
+
+	for i, input := range m.inputs {
+		var renderedInput string
+		if m.focusIndex == i {
+			renderedInput = focusedInputStyle.Render(input.View())
+		} else {
+			renderedInput = inputStyle.Render(input.View())
+		}
+
+		s += fmt.Sprintf("%s\n%s\n",
+			subtitleStyle.Render(labels[i]),
+			renderedInput,
+		)
+	}
+
+	s += "\n" + helpStyle.Render("TAB: Next field") + "\n"
+	s += helpStyle.Render("ENTER: Save card") + "\n"
+	s += helpStyle.Render("ESC: Cancel") + "\n"
+
+	return s
+}
@@ -0,0 +1,41 @@
+package tui
+
+import (
+	"log"
+	"sync"
+
+	Providers "chinese_vocab/providers"
+	Words "chinese_vocab/words"
+)
+
+// basemodel holds the state shared by every screen: the deck itself, where
+// it persists to, and the configured example provider. Screens hold a
+// pointer to a single basemodel so that, for example, a card added on the
+// add screen is immediately visible back on the browse screen.
+//
+// mu guards words. Within a single Bubble Tea program, Update runs on one
+// goroutine so screens never race each other; mu exists for hosts like
+// cmd/pinyin-ssh that call SaveDeck from outside that goroutine (e.g. to
+// flush every session on shutdown) while the program's own Update may be
+// mutating words at the same time.
+type basemodel struct {
+	mu       sync.Mutex
+	words    []Words.Word
+	deckPath string
+	provider Providers.ExampleProvider
+}
+
+// saveDeck persists the current words, including their SRS scheduling
+// state, to disk.
+func (b *basemodel) saveDeck() {
+	if b.deckPath == "" {
+		return
+	}
+	b.mu.Lock()
+	words := append([]Words.Word(nil), b.words...)
+	b.mu.Unlock()
+
+	if err := Words.SaveDeck(b.deckPath, words); err != nil {
+		log.Printf("could not save deck to %s: %v", b.deckPath, err)
+	}
+}
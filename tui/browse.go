@@ -0,0 +1,502 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"chinese_vocab/deck"
+	Providers "chinese_vocab/providers"
+	Words "chinese_vocab/words"
+
+	"github.com/charmbracelet/bubbles/filepicker"
+	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// fetchTimeout bounds how long a single example request may run before it
+// is cancelled.
+const fetchTimeout = 20 * time.Second
+
+// exportFormats lists the extensions deck.Export dispatches on, in the
+// order "tab" cycles through them on the export picker.
+var exportFormats = []string{".csv", ".json", ".apkg"}
+
+// exportFileName is the name written into whatever directory the user picks
+// when exporting, since the file picker bubble can browse to a directory
+// but has no "save as" input of its own; the extension comes from whichever
+// format is currently selected.
+func exportFileName(format string) string {
+	return "gopinyin-export" + format
+}
+
+// pickerMode tracks why the file picker overlay is open, since 'i' and 'e'
+// share the same widget but act on a selection differently.
+type pickerMode int
+
+const (
+	pickerNone pickerMode = iota
+	pickerImport
+	pickerExport
+)
+
+// browseModel is the flashcard navigation screen: step through the deck,
+// reveal a card's definition and example, and jump into add/review mode.
+type browseModel struct {
+	base *basemodel
+
+	currentIndex   int
+	showDetails    bool
+	loadingExample bool
+
+	spinner  spinner.Model
+	viewport viewport.Model
+
+	// fetchCtx/fetchCancel track the in-flight example request, if any, so
+	// that navigating away aborts it instead of letting it race the UI.
+	fetchCtx    context.Context
+	fetchCancel context.CancelFunc
+
+	// streamSub is the channel the current streaming provider's goroutine
+	// delivers exampleChunkMsg/exampleDoneMsg on; the Bubble Tea loop keeps
+	// draining it via waitForChunk until the stream finishes. beginFetch
+	// allocates a fresh channel for every fetch rather than reusing one
+	// for the model's lifetime, so a cancelled fetch's goroutine can never
+	// hand its stale completion message to the next fetch's reader.
+	streamSub chan tea.Msg
+
+	// picker is the import/export file browser overlay, active when mode
+	// is not pickerNone.
+	picker     filepicker.Model
+	pickerMode pickerMode
+	statusMsg  string
+
+	// exportFormat is the extension (one of exportFormats) the export
+	// picker will write, cycled with tab.
+	exportFormat string
+}
+
+// importExportMsg reports the result of an import or export kicked off from
+// the file picker overlay. words is populated on a successful import so
+// Update can merge it into the deck.
+type importExportMsg struct {
+	mode  pickerMode
+	path  string
+	words []Words.Word
+	err   error
+}
+
+// exampleMsg carries the example sentence fetched from a non-streaming
+// provider.
+type exampleMsg struct {
+	Index   int
+	Example string
+	Error   error
+}
+
+// exampleChunkMsg carries one incremental token from a streaming provider.
+type exampleChunkMsg struct {
+	Index int
+	Delta string
+}
+
+// exampleDoneMsg signals that a streaming example request has finished,
+// successfully or not.
+type exampleDoneMsg struct {
+	Index int
+	Error error
+}
+
+func newBrowseModel(base *basemodel) browseModel {
+	sp := spinner.New()
+	sp.Spinner = spinner.Dot
+	sp.Style = subtitleDarkStyle
+
+	fp := filepicker.New()
+	fp.AllowedTypes = []string{".csv", ".json", ".apkg"}
+	if dir, err := os.UserHomeDir(); err == nil {
+		fp.CurrentDirectory = dir
+	}
+
+	return browseModel{
+		base:         base,
+		spinner:      sp,
+		viewport:     viewport.New(46, 6),
+		picker:       fp,
+		exportFormat: exportFormats[0],
+	}
+}
+
+func (m browseModel) Init() tea.Cmd {
+	return m.spinner.Tick
+}
+
+func (m browseModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if m.pickerMode != pickerNone {
+		return m.updatePicker(msg)
+	}
+
+	switch msg := msg.(type) {
+	case importExportMsg:
+		if msg.err != nil {
+			m.statusMsg = fmt.Sprintf("%s failed: %v", importExportLabel(msg.mode), msg.err)
+			return m, nil
+		}
+		if msg.mode == pickerImport {
+			m.base.mu.Lock()
+			m.base.words = append(m.base.words, msg.words...)
+			m.base.mu.Unlock()
+			m.base.saveDeck()
+			m.statusMsg = fmt.Sprintf("imported %d card(s) from %s", len(msg.words), msg.path)
+		} else {
+			m.statusMsg = fmt.Sprintf("exported to %s", msg.path)
+		}
+		return m, nil
+
+	case exampleMsg:
+		m.loadingExample = false
+		m.cancelFetch()
+		if msg.Error != nil {
+			if errors.Is(msg.Error, context.Canceled) {
+				return m, nil
+			}
+			m.base.mu.Lock()
+			m.base.words[msg.Index].Example = fmt.Sprintf("Error: %v", msg.Error)
+			m.base.mu.Unlock()
+		} else {
+			m.base.mu.Lock()
+			m.base.words[msg.Index].Example = msg.Example
+			m.base.mu.Unlock()
+		}
+		return m, nil
+
+	case exampleChunkMsg:
+		if msg.Index < len(m.base.words) {
+			m.base.mu.Lock()
+			m.base.words[msg.Index].Example += msg.Delta
+			m.base.mu.Unlock()
+		}
+		return m, waitForChunk(m.fetchCtx, m.streamSub, msg.Index)
+
+	case exampleDoneMsg:
+		m.loadingExample = false
+		m.cancelFetch()
+		if msg.Error != nil && !errors.Is(msg.Error, context.Canceled) {
+			m.base.mu.Lock()
+			m.base.words[msg.Index].Example = fmt.Sprintf("Error: %v", msg.Error)
+			m.base.mu.Unlock()
+		}
+		return m, nil
+
+	case spinner.TickMsg:
+		if !m.loadingExample {
+			return m, nil
+		}
+		var cmd tea.Cmd
+		m.spinner, cmd = m.spinner.Update(msg)
+		return m, cmd
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			m.base.saveDeck()
+			return m, tea.Quit
+
+		case "r":
+			m.cancelFetch()
+			return m, switchScreen(screenReview)
+
+		case "right", "l":
+			m.cancelFetch()
+			m.currentIndex = (m.currentIndex + 1) % len(m.base.words)
+			m.showDetails = false
+			m.loadingExample = false
+
+		case "left", "h":
+			m.cancelFetch()
+			m.currentIndex = (m.currentIndex - 1 + len(m.base.words)) % len(m.base.words)
+			m.showDetails = false
+			m.loadingExample = false
+
+		case " ", "enter":
+			m.showDetails = !m.showDetails
+			if m.showDetails {
+				return m.beginFetch()
+			}
+			m.cancelFetch()
+			m.loadingExample = false
+
+		case "up", "down", "pgup", "pgdown":
+			if m.showDetails {
+				var cmd tea.Cmd
+				m.viewport, cmd = m.viewport.Update(msg)
+				return m, cmd
+			}
+
+		case "a":
+			m.cancelFetch()
+			return m, switchScreen(screenAdd)
+
+		case "i":
+			m.cancelFetch()
+			m.statusMsg = ""
+			m.pickerMode = pickerImport
+			m.picker.AllowedTypes = []string{".csv", ".json", ".apkg"}
+			return m, m.picker.Init()
+
+		case "e":
+			m.cancelFetch()
+			m.statusMsg = ""
+			m.pickerMode = pickerExport
+			m.exportFormat = exportFormats[0]
+			m.picker.AllowedTypes = nil
+			return m, m.picker.Init()
+		}
+	}
+
+	return m, nil
+}
+
+// updatePicker routes messages to the file picker overlay while it is open,
+// confirming a selection (import) or the current directory via ctrl+s
+// (export) once the user has navigated where they want.
+func (m browseModel) updatePicker(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if key, ok := msg.(tea.KeyMsg); ok {
+		switch key.String() {
+		case "esc", "ctrl+c":
+			m.pickerMode = pickerNone
+			return m, nil
+
+		case "tab":
+			if m.pickerMode == pickerExport {
+				m.exportFormat = nextExportFormat(m.exportFormat)
+				return m, nil
+			}
+
+		case "ctrl+s":
+			if m.pickerMode == pickerExport {
+				mode := m.pickerMode
+				path := filepath.Join(m.picker.CurrentDirectory, exportFileName(m.exportFormat))
+				m.pickerMode = pickerNone
+				return m, exportDeck(mode, path, m.base.words)
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.picker, cmd = m.picker.Update(msg)
+
+	if didSelect, path := m.picker.DidSelectFile(msg); didSelect && m.pickerMode == pickerImport {
+		mode := m.pickerMode
+		m.pickerMode = pickerNone
+		return m, importDeck(mode, path)
+	}
+
+	return m, cmd
+}
+
+// beginFetch kicks off an example request for the current card, streaming
+// it token-by-token when the provider supports it.
+func (m browseModel) beginFetch() (tea.Model, tea.Cmd) {
+	index := m.currentIndex
+	word := m.base.words[index]
+
+	m.loadingExample = true
+	ctx, cancel := context.WithTimeout(context.Background(), fetchTimeout)
+	m.fetchCtx = ctx
+	m.fetchCancel = cancel
+
+	if sp, ok := m.base.provider.(Providers.StreamingExampleProvider); ok {
+		m.base.mu.Lock()
+		m.base.words[index].Example = ""
+		m.base.mu.Unlock()
+		m.streamSub = make(chan tea.Msg)
+		return m, tea.Batch(m.spinner.Tick, streamExample(ctx, sp, word, index, m.streamSub))
+	}
+	return m, tea.Batch(m.spinner.Tick, fetchExample(ctx, m.base.provider, word, index))
+}
+
+// cancelFetch aborts the in-flight example request, if any.
+func (m *browseModel) cancelFetch() {
+	if m.fetchCancel != nil {
+		m.fetchCancel()
+		m.fetchCancel = nil
+	}
+}
+
+func (m browseModel) View() string {
+	if m.pickerMode != pickerNone {
+		return m.pickerView()
+	}
+
+	if len(m.base.words) == 0 {
+		return "No words available.\n"
+	}
+
+	currentWord := m.base.words[m.currentIndex]
+
+	// Card content
+	cardContent := fmt.Sprintf(
+		"%s: %s\n%s: %s",
+		subtitleStyle.Render("Pinyin"),
+		exampleTextStyle.Render(currentWord.Pinyin),
+		subtitleRedStyle.Render("Chinese"),
+		exampleTextStyle.Render(currentWord.Chinese),
+	)
+
+	// Additional details
+	var detailsContent string
+	if m.showDetails {
+		if m.loadingExample && currentWord.Example == "" {
+			detailsContent = "\n" + m.spinner.View() + " " + subtitleDarkStyle.Render("Loading example...")
+		} else {
+			m.viewport.SetContent(currentWord.Example)
+			examplePane := m.viewport.View()
+			if m.loadingExample {
+				examplePane += " " + m.spinner.View()
+			}
+
+			detailsContent = fmt.Sprintf(
+				"\n%s: %s\n\n%s\n%s",
+				subtitleDarkStyle.Render("Definition"),
+				exampleTextStyle.Render(currentWord.Definition),
+				subtitleDarkStyle.Render("Example"),
+				examplePane,
+			)
+		}
+	}
+
+	// Combine everything
+	view := lipgloss.NewStyle().
+		Width(50).
+		Align(lipgloss.Center).
+		Render(
+			titleStyle.Render("Pinyin Vocab Flashcards") +
+				fmt.Sprintf(" (%d/%d)\n\n", m.currentIndex+1, len(m.base.words)) +
+				cardStyle.Render(cardContent+detailsContent) +
+				"\n\n" +
+				helpStyle.Render("Controls:") + "\n" +
+				fmt.Sprintf("← / h : Previous word \n") +
+				fmt.Sprintf("→ / l : Next word     \n") +
+				fmt.Sprintf("SPACE : Toggle details\n") +
+				fmt.Sprintf("a     : Add new card  \n") +
+				fmt.Sprintf("r     : Review (SRS)  \n") +
+				fmt.Sprintf("i     : Import deck   \n") +
+				fmt.Sprintf("e     : Export deck   \n") +
+				fmt.Sprintf("q     : Quit          \n"),
+		)
+
+	if m.statusMsg != "" {
+		view += "\n" + subtitleDarkStyle.Render(m.statusMsg)
+	}
+
+	return view
+}
+
+// pickerView renders the import/export file browser overlay.
+func (m browseModel) pickerView() string {
+	label := "Select a deck file to import (csv, json, apkg)"
+	help := "enter: select   esc: cancel"
+	if m.pickerMode == pickerExport {
+		label = "Browse to a directory, then press ctrl+s to export " + exportFileName(m.exportFormat) + " there"
+		help = "tab: change format   ctrl+s: export here   esc: cancel"
+	}
+
+	return lipgloss.NewStyle().Width(60).Render(
+		titleStyle.Render(label) + "\n\n" +
+			m.picker.View() + "\n" +
+			helpStyle.Render(help),
+	)
+}
+
+// nextExportFormat cycles through exportFormats, wrapping back to the first
+// after the last.
+func nextExportFormat(current string) string {
+	for i, f := range exportFormats {
+		if f == current {
+			return exportFormats[(i+1)%len(exportFormats)]
+		}
+	}
+	return exportFormats[0]
+}
+
+// importExportLabel names a pickerMode for status/error messages.
+func importExportLabel(mode pickerMode) string {
+	if mode == pickerImport {
+		return "import"
+	}
+	return "export"
+}
+
+// importDeck reads words from path in a background command so the file
+// picker overlay doesn't block on disk/parsing work.
+func importDeck(mode pickerMode, path string) tea.Cmd {
+	return func() tea.Msg {
+		words, err := deck.Import(path)
+		return importExportMsg{mode: mode, path: path, words: words, err: err}
+	}
+}
+
+// exportDeck writes words to path in a background command.
+func exportDeck(mode pickerMode, path string, words []Words.Word) tea.Cmd {
+	return func() tea.Msg {
+		err := deck.Export(path, words)
+		return importExportMsg{mode: mode, path: path, err: err}
+	}
+}
+
+// fetchExample asks the configured provider for an example sentence. It
+// respects ctx cancellation so that navigating away from a card aborts the
+// in-flight request instead of letting it race the UI.
+func fetchExample(ctx context.Context, provider Providers.ExampleProvider, word Words.Word, index int) tea.Cmd {
+	return func() tea.Msg {
+		example, err := provider.FetchExample(ctx, word)
+		if err != nil {
+			return exampleMsg{Index: index, Error: err}
+		}
+		return exampleMsg{Index: index, Example: example}
+	}
+}
+
+// streamExample kicks off a streaming provider's fetch in a background
+// goroutine that feeds exampleChunkMsg/exampleDoneMsg into sub, then returns
+// a command that reads the first of those messages off sub. Update
+// re-issues waitForChunk after each chunk to keep draining until the stream
+// is done; cancelling ctx stops the goroutine from writing any further
+// chunks.
+func streamExample(ctx context.Context, provider Providers.StreamingExampleProvider, word Words.Word, index int, sub chan tea.Msg) tea.Cmd {
+	return func() tea.Msg {
+		go func() {
+			err := provider.StreamExample(ctx, word, func(delta string) {
+				select {
+				case sub <- exampleChunkMsg{Index: index, Delta: delta}:
+				case <-ctx.Done():
+				}
+			})
+			select {
+			case sub <- exampleDoneMsg{Index: index, Error: err}:
+			case <-ctx.Done():
+			}
+		}()
+		return waitForChunk(ctx, sub, index)()
+	}
+}
+
+// waitForChunk returns a command that blocks until either the next message
+// arrives on sub or ctx is done, so a cancelled stream can't leak a command
+// blocked on a channel nothing will ever write to again.
+func waitForChunk(ctx context.Context, sub chan tea.Msg, index int) tea.Cmd {
+	return func() tea.Msg {
+		select {
+		case msg := <-sub:
+			return msg
+		case <-ctx.Done():
+			return exampleDoneMsg{Index: index, Error: ctx.Err()}
+		}
+	}
+}
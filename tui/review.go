@@ -0,0 +1,153 @@
+package tui
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// reviewModel is a spaced-repetition review session: walk through the cards
+// due today, reveal each on demand, and schedule the next review from a
+// 0-5 self-graded recall.
+type reviewModel struct {
+	base *basemodel
+
+	queue       []int // indices into base.words, due cards for this session
+	pos         int   // position within queue
+	showDetails bool
+	graded      int // cards graded so far this session
+	correct     int // cards graded with q >= 3
+}
+
+// newReviewModel builds a shuffled queue of the cards due today. If nothing
+// is due, the queue is empty and View says so.
+func newReviewModel(base *basemodel) reviewModel {
+	now := time.Now()
+
+	var queue []int
+	for i, w := range base.words {
+		if w.DueToday(now) {
+			queue = append(queue, i)
+		}
+	}
+	rand.Shuffle(len(queue), func(i, j int) {
+		queue[i], queue[j] = queue[j], queue[i]
+	})
+
+	return reviewModel{base: base, queue: queue}
+}
+
+func (m reviewModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m reviewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+
+	switch keyMsg.String() {
+	case "ctrl+c", "esc", "q":
+		m.base.saveDeck()
+		return m, switchScreen(screenBrowse)
+
+	case " ", "enter":
+		m.showDetails = !m.showDetails
+
+	case "0", "1", "2", "3", "4", "5":
+		if !m.showDetails || m.pos >= len(m.queue) {
+			break
+		}
+
+		q := int(keyMsg.String()[0] - '0')
+		m.base.mu.Lock()
+		word := &m.base.words[m.queue[m.pos]]
+		word.ApplyGrade(q, time.Now())
+		m.base.mu.Unlock()
+
+		m.graded++
+		if q >= 3 {
+			m.correct++
+		}
+
+		m.pos++
+		m.showDetails = false
+
+		if m.pos >= len(m.queue) {
+			m.base.saveDeck()
+			return m, switchScreen(screenBrowse)
+		}
+	}
+
+	return m, nil
+}
+
+// View renders the review session: the prompt side of the current due
+// card, then, once revealed, its Chinese/example and the 0-5 grading scale.
+func (m reviewModel) View() string {
+	if len(m.queue) == 0 {
+		return lipgloss.NewStyle().
+			Width(50).
+			Align(lipgloss.Center).
+			Render(
+				titleStyle.Render("Review") + "\n\n" +
+					cardStyle.Render(subtitleDarkStyle.Render("Nothing is due for review right now.")) +
+					"\n\n" +
+					helpStyle.Render("Controls:") + "\n" +
+					fmt.Sprintf("q : Back to deck\n"),
+			)
+	}
+
+	word := m.base.words[m.queue[m.pos]]
+
+	retention := 100.0
+	if m.graded > 0 {
+		retention = float64(m.correct) / float64(m.graded) * 100
+	}
+
+	header := titleStyle.Render("Review") +
+		fmt.Sprintf(" (%d/%d, retention %.0f%%)\n\n", m.pos+1, len(m.queue), retention)
+
+	cardContent := fmt.Sprintf(
+		"%s: %s\n%s: %s",
+		subtitleStyle.Render("Pinyin"),
+		exampleTextStyle.Render(word.Pinyin),
+		subtitleRedStyle.Render("Definition"),
+		exampleTextStyle.Render(word.Definition),
+	)
+
+	var detailsContent string
+	var help string
+	if m.showDetails {
+		detailsContent = fmt.Sprintf(
+			"\n%s: %s\n\n%s\n%s",
+			subtitleDarkStyle.Render("Chinese"),
+			exampleTextStyle.Render(word.Chinese),
+			subtitleDarkStyle.Render("Example"),
+			exampleTextStyle.Render(word.Example),
+		)
+		help = helpStyle.Render("Controls:") + "\n" +
+			fmt.Sprintf("0-5 : Grade recall (0=blackout, 5=perfect)\n") +
+			fmt.Sprintf("q   : End session                        \n")
+	} else {
+		help = helpStyle.Render("Controls:") + "\n" +
+			fmt.Sprintf("SPACE : Reveal answer\n") +
+			fmt.Sprintf("q     : End session  \n")
+	}
+
+	view := lipgloss.NewStyle().
+		Width(50).
+		Align(lipgloss.Center).
+		Render(
+			header +
+				cardStyle.Render(cardContent+detailsContent) +
+				"\n\n" +
+				help,
+		)
+
+	return view
+}
@@ -0,0 +1,153 @@
+package tui
+
+import (
+	Providers "chinese_vocab/providers"
+	Words "chinese_vocab/words"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// configPath is the on-disk location of the provider configuration file,
+// resolved relative to the working directory (following the pattern of the
+// `.env` file loaded at startup).
+const configPath = "config.yaml"
+
+// screen identifies which child model is currently in control.
+type screen int
+
+const (
+	screenBrowse screen = iota
+	screenAdd
+	screenReview
+)
+
+// switchScreenMsg is returned by a child model's Update to hand control back
+// to rootModel, which routes subsequent messages to the requested screen.
+type switchScreenMsg struct {
+	to screen
+}
+
+// switchScreen returns a command that requests a screen change.
+func switchScreen(to screen) tea.Cmd {
+	return func() tea.Msg {
+		return switchScreenMsg{to: to}
+	}
+}
+
+// rootModel routes tea.Msgs to whichever child model is active, removing the
+// single ballooning switch a monolithic model would need.
+type rootModel struct {
+	base   *basemodel
+	active screen
+
+	browse browseModel
+	add    addModel
+	review reviewModel
+}
+
+// New loads the deck and provider configuration and builds the top-level
+// model, starting on the browse screen.
+func New() (rootModel, error) {
+	deckPath, err := Words.DeckPath()
+	if err != nil {
+		return rootModel{}, err
+	}
+
+	return NewWithDeckPath(deckPath)
+}
+
+// NewWithDeckPath builds the top-level model using deckPath instead of the
+// default per-user deck location. This is what lets a multi-user server
+// give each connected user their own deck file while sharing one provider
+// configuration.
+func NewWithDeckPath(deckPath string) (rootModel, error) {
+	words, err := Words.LoadDeck(deckPath)
+	if err != nil {
+		return rootModel{}, err
+	}
+
+	cfg, err := Providers.LoadConfig(configPath)
+	if err != nil {
+		return rootModel{}, err
+	}
+
+	provider, err := Providers.New(cfg)
+	if err != nil {
+		return rootModel{}, err
+	}
+
+	base := &basemodel{words: words, deckPath: deckPath, provider: provider}
+
+	return rootModel{
+		base:   base,
+		active: screenBrowse,
+		browse: newBrowseModel(base),
+		add:    newAddModel(base),
+		review: newReviewModel(base),
+	}, nil
+}
+
+// Saveable is implemented by any tui model that can flush its deck to disk.
+// Hosts that manage the Bubble Tea program lifecycle themselves (e.g. a
+// multi-user SSH server) use it to persist every session's state on
+// shutdown.
+type Saveable interface {
+	SaveDeck()
+}
+
+// SaveDeck persists the current deck to disk. m.base is a pointer shared by
+// every screen for the lifetime of the session, so this flushes whatever
+// the session has mutated so far even though rootModel itself is passed
+// around by value.
+func (m rootModel) SaveDeck() {
+	m.base.saveDeck()
+}
+
+func (m rootModel) Init() tea.Cmd {
+	return m.browse.Init()
+}
+
+func (m rootModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if sw, ok := msg.(switchScreenMsg); ok {
+		m.active = sw.to
+
+		var initCmd tea.Cmd
+		switch sw.to {
+		case screenReview:
+			m.review = newReviewModel(m.base)
+			initCmd = m.review.Init()
+		case screenAdd:
+			initCmd = m.add.Init()
+		default:
+			initCmd = m.browse.Init()
+		}
+		return m, initCmd
+	}
+
+	var updated tea.Model
+	var cmd tea.Cmd
+	switch m.active {
+	case screenAdd:
+		updated, cmd = m.add.Update(msg)
+		m.add = updated.(addModel)
+	case screenReview:
+		updated, cmd = m.review.Update(msg)
+		m.review = updated.(reviewModel)
+	default:
+		updated, cmd = m.browse.Update(msg)
+		m.browse = updated.(browseModel)
+	}
+
+	return m, cmd
+}
+
+func (m rootModel) View() string {
+	switch m.active {
+	case screenAdd:
+		return m.add.View()
+	case screenReview:
+		return m.review.View()
+	default:
+		return m.browse.View()
+	}
+}
@@ -0,0 +1,57 @@
+package tui
+
+import "github.com/charmbracelet/lipgloss"
+
+// Styling variables shared by every screen.
+var (
+	// Color palette
+	colorPrimary          = lipgloss.Color("#2C7BB6") // Soft blue
+	colorSecondary        = lipgloss.Color("#D7191C") // Warm red
+	colorAccent           = lipgloss.Color("#1A9641") // Dark green
+	colorBackground       = lipgloss.Color("#F7F7F7") // Light gray background
+	colorText             = lipgloss.Color("#333333") // Dark gray text
+	transparentBackground = lipgloss.Color("transparent")
+
+	// Styles
+	titleStyle = lipgloss.NewStyle().
+			Foreground(colorPrimary).
+			Bold(true).
+			Padding(0, 1)
+
+	subtitleStyle = lipgloss.NewStyle().
+			Foreground(colorAccent).
+			Italic(true)
+
+	subtitleRedStyle = lipgloss.NewStyle().
+				Foreground(colorSecondary).
+				Italic(true)
+
+	subtitleDarkStyle = lipgloss.NewStyle().
+				Foreground(colorPrimary).
+				Italic(true)
+
+	exampleTextStyle = lipgloss.NewStyle()
+
+	cardStyle = lipgloss.NewStyle().
+			Background(transparentBackground).
+			BorderStyle(lipgloss.RoundedBorder()).
+			BorderForeground(colorPrimary).
+			Padding(1, 2)
+
+	helpStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#666")).
+			Italic(true)
+
+	inputStyle = lipgloss.NewStyle().
+			Background(transparentBackground).
+			BorderStyle(lipgloss.NormalBorder()).
+			BorderForeground(colorPrimary).
+			Padding(0, 1)
+
+	focusedInputStyle = inputStyle.Copy().
+				BorderForeground(colorSecondary)
+
+	successStyle = lipgloss.NewStyle().
+			Foreground(colorAccent).
+			Bold(true)
+)
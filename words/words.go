@@ -1,11 +1,25 @@
 package example_words
 
+import (
+	"encoding/json"
+	"math"
+	"os"
+	"path/filepath"
+	"time"
+)
+
 // Word represents a vocabulary entry
 type Word struct {
 	Pinyin     string
 	Chinese    string
 	Definition string
 	Example    string
+
+	// SRS scheduling state (SuperMemo-2)
+	Repetitions int       `json:"repetitions"`
+	EaseFactor  float64   `json:"easeFactor"`
+	Interval    int       `json:"interval"`
+	DueDate     time.Time `json:"dueDate"`
 }
 
 var ExampleWords = []Word{
@@ -70,3 +84,94 @@ var ExampleWords = []Word{
 		Example:    "Wǒ de jiā zài Běijīng.",
 	},
 }
+
+// defaultEaseFactor is the SM-2 starting ease for a card that has never been graded.
+const defaultEaseFactor = 2.5
+
+// DueToday reports whether the word is due for review on or before the given day.
+func (w Word) DueToday(today time.Time) bool {
+	if w.DueDate.IsZero() {
+		return true
+	}
+	return !w.DueDate.After(today)
+}
+
+// ApplyGrade schedules the next review using the SuperMemo-2 algorithm for a
+// self-graded recall quality q in 0..5 (5 being a perfect recall).
+func (w *Word) ApplyGrade(q int, now time.Time) {
+	if w.EaseFactor == 0 {
+		w.EaseFactor = defaultEaseFactor
+	}
+
+	if q < 3 {
+		w.Repetitions = 0
+		w.Interval = 1
+	} else {
+		switch w.Repetitions {
+		case 0:
+			w.Interval = 1
+		case 1:
+			w.Interval = 6
+		default:
+			w.Interval = int(math.Round(float64(w.Interval) * w.EaseFactor))
+		}
+		w.Repetitions++
+	}
+
+	w.EaseFactor = w.EaseFactor + (0.1 - float64(5-q)*(0.08+float64(5-q)*0.02))
+	if w.EaseFactor < 1.3 {
+		w.EaseFactor = 1.3
+	}
+
+	w.DueDate = now.AddDate(0, 0, w.Interval)
+}
+
+// DeckPath returns the on-disk location of the persisted deck: an
+// XDG-compliant config path when available, falling back to a file next to
+// the executable.
+func DeckPath() (string, error) {
+	if dir, err := os.UserConfigDir(); err == nil {
+		return filepath.Join(dir, "gopinyin", "deck.json"), nil
+	}
+
+	exe, err := os.Executable()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(filepath.Dir(exe), "deck.json"), nil
+}
+
+// LoadDeck reads the deck from path. If the file does not exist, it returns a
+// copy of ExampleWords so callers have a starter deck to save on first write.
+func LoadDeck(path string) ([]Word, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		words := make([]Word, len(ExampleWords))
+		copy(words, ExampleWords)
+		return words, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var words []Word
+	if err := json.Unmarshal(data, &words); err != nil {
+		return nil, err
+	}
+	return words, nil
+}
+
+// SaveDeck writes words to path as indented JSON, creating parent
+// directories as needed.
+func SaveDeck(path string, words []Word) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(words, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0o644)
+}
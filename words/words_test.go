@@ -0,0 +1,100 @@
+package example_words
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestApplyGrade(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		word         Word
+		q            int
+		wantInterval int
+		wantReps     int
+	}{
+		{
+			name:         "first successful recall schedules 1 day",
+			word:         Word{},
+			q:            4,
+			wantInterval: 1,
+			wantReps:     1,
+		},
+		{
+			name:         "second successful recall schedules 6 days",
+			word:         Word{Repetitions: 1, EaseFactor: 2.5, Interval: 1},
+			q:            5,
+			wantInterval: 6,
+			wantReps:     2,
+		},
+		{
+			name:         "later recalls multiply interval by ease factor",
+			word:         Word{Repetitions: 2, EaseFactor: 2.5, Interval: 6},
+			q:            4,
+			wantInterval: int(math.Round(6 * 2.5)),
+			wantReps:     3,
+		},
+		{
+			name:         "failing recall resets repetitions and interval",
+			word:         Word{Repetitions: 4, EaseFactor: 2.3, Interval: 20},
+			q:            2,
+			wantInterval: 1,
+			wantReps:     0,
+		},
+		{
+			name:         "ease factor never drops below 1.3",
+			word:         Word{Repetitions: 1, EaseFactor: 1.3, Interval: 1},
+			q:            0,
+			wantInterval: 1,
+			wantReps:     0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := tt.word
+			w.ApplyGrade(tt.q, now)
+
+			if w.Interval != tt.wantInterval {
+				t.Errorf("Interval = %d, want %d", w.Interval, tt.wantInterval)
+			}
+			if w.Repetitions != tt.wantReps {
+				t.Errorf("Repetitions = %d, want %d", w.Repetitions, tt.wantReps)
+			}
+			if w.EaseFactor < 1.3 {
+				t.Errorf("EaseFactor = %v, must never drop below 1.3", w.EaseFactor)
+			}
+			wantDue := now.AddDate(0, 0, tt.wantInterval)
+			if !w.DueDate.Equal(wantDue) {
+				t.Errorf("DueDate = %v, want %v", w.DueDate, wantDue)
+			}
+		})
+	}
+}
+
+func TestDueToday(t *testing.T) {
+	now := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		due  time.Time
+		want bool
+	}{
+		{"never reviewed", time.Time{}, true},
+		{"due in the past", now.AddDate(0, 0, -1), true},
+		{"due today", now, true},
+		{"due in the future", now.AddDate(0, 0, 1), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := Word{DueDate: tt.due}
+			if got := w.DueToday(now); got != tt.want {
+				t.Errorf("DueToday() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}